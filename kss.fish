@@ -0,0 +1,112 @@
+# fish completion for kss, copy it to ~/.config/fish/completions/kss.fish
+
+function __kss_flags
+    # the context, kubeconfig and namespace (unless asked for the cluster
+    # ones only) already typed on the command line
+    set -l tokens (commandline -opc)
+    for i in (seq (count $tokens))
+        switch $tokens[$i]
+            case -n --namespace
+                test "$argv[1]" = cluster; or printf '%s\n' --namespace $tokens[(math $i + 1)]
+            case '--namespace=*'
+                test "$argv[1]" = cluster; or printf '%s\n' $tokens[$i]
+            case --context --kubeconfig
+                printf '%s\n' $tokens[$i] $tokens[(math $i + 1)]
+            case '--context=*' '--kubeconfig=*'
+                printf '%s\n' $tokens[$i]
+        end
+    end
+end
+
+function __kss_kubectl
+    command kubectl (__kss_flags) $argv 2>/dev/null
+end
+
+function __kss_namespaces
+    command kubectl (__kss_flags cluster) get namespace -o name 2>/dev/null | string replace -r '^namespace/' ''
+end
+
+function __kss_pods
+    __kss_kubectl get pod -o name | string replace -r '^pod/' ''
+end
+
+function __kss_containers
+    # complete the containers of the pods already on the command line
+    set -l tokens (commandline -opc)
+    set -e tokens[1]
+    set -l skip 0
+    for token in $tokens
+        if test $skip -gt 0
+            set skip (math $skip - 1)
+            continue
+        end
+        switch $token
+            case -n --namespace --kubeconfig --context -r --restrict -o --output --maxlines --since \
+                --since-time --sort --status-filter --nettest --debug-image --job --cronjob --serve \
+                --selector --interval --collect --from-file --from-dir --report-html --report-markdown \
+                --theme --timezone --timeout --clipboard --yank
+                set skip 1
+            case --diff --cp
+                set skip 2
+            case '-*'
+            case '*'
+                __kss_kubectl get pod $token -o jsonpath='{range .spec.containers[*]}{.name}{"\n"}{end}{range .spec.initContainers[*]}{.name}{"\n"}{end}'
+        end
+    end
+end
+
+complete -c kss -f -a '(__kss_pods)'
+complete -c kss -s n -l namespace -x -d 'Use namespace' -a '(__kss_namespaces)'
+complete -c kss -l kubeconfig -r -F -d 'Path to the kubeconfig file'
+complete -c kss -l context -x -d 'Kubeconfig context' -a '(kubectl config get-contexts -o name 2>/dev/null)'
+complete -c kss -s r -l restrict -x -d 'Restrict containers to' -a '(__kss_containers)'
+complete -c kss -s l -l showlog -d 'Show log'
+complete -c kss -s P -l previous -d 'Show log of the previous instance of restarted containers'
+complete -c kss -s E -l events -d 'Show events'
+complete -c kss -s V -l volumes -d 'Show volumes'
+complete -c kss -s F -l port-forward -d 'Port forward to a container port'
+complete -c kss -s o -l output -x -a wide -d 'Output format'
+complete -c kss -l maxlines -x -d 'Maximum line when showing logs'
+complete -c kss -l since -x -d 'Show logs newer than a duration'
+complete -c kss -l since-time -x -d 'Show logs after a date'
+complete -c kss -l timestamps -d 'Show timestamps on log lines'
+complete -c kss -l relative -d 'Show log timestamps relative to now'
+complete -c kss -l raw-logs -d 'Do not pretty print JSON log lines'
+complete -c kss -l network -d 'Show the services selecting the pod'
+complete -c kss -l node -d 'Check the node of the pod'
+complete -c kss -l deployment-info -d 'Show which GitOps tool deployed the pod'
+complete -c kss -l image-info -d 'Show where the images come from'
+complete -c kss -l no-state -d 'Do not remember the pods between runs'
+complete -c kss -l short -d 'Only print a one line verdict per pod'
+complete -c kss -l summary -d 'Show a table of the pods'
+complete -c kss -l sort -x -a 'age restarts name status' -d 'Sort the list of pods'
+complete -c kss -l status-filter -x -a 'failed running pending' -d 'Only list pods with this status'
+complete -c kss -l startup -d 'Show the pod startup latency'
+complete -c kss -l timeline -d 'Show the init containers timeline'
+complete -c kss -l history -d 'Show the restarts history'
+complete -c kss -l metrics -d 'Show resources usage'
+complete -c kss -l nettest -x -d 'Test connectivity from a container'
+complete -c kss -l clipboard -l yank -x -a 'name logs output' -d 'Copy to the clipboard'
+complete -c kss -l diff -x -d 'Compare two pods' -a '(__kss_pods)'
+complete -c kss -l cp -r -d 'Copy files from/to the pod'
+complete -c kss -l debug -d 'Attach an ephemeral debug container'
+complete -c kss -l debug-image -x -d 'Image of the debug container'
+complete -c kss -l job -x -d 'Show a Job' -a '(__kss_kubectl get job -o name | string replace -r "^job.batch/" "")'
+complete -c kss -l cronjob -x -d 'Show a CronJob' -a '(__kss_kubectl get cronjob -o name | string replace -r "^cronjob.batch/" "")'
+complete -c kss -l anomalies -d 'Flag pods restarting more than their siblings'
+complete -c kss -l version -d 'Show the version of kss'
+complete -c kss -l version-check -d 'Check the cluster compatibility'
+complete -c kss -l serve -x -d 'Serve the pods health'
+complete -c kss -l selector -x -d 'Label selector of the pods to serve'
+complete -c kss -l interval -x -d 'Refresh interval when serving'
+complete -c kss -l collect -r -a '(__fish_complete_directories)' -d 'Save a snapshot bundle'
+complete -c kss -l from-file -r -F -d 'Show a pod from a JSON file'
+complete -c kss -l from-dir -r -a '(__fish_complete_directories)' -d 'Show a pod from a snapshot bundle'
+complete -c kss -l report-html -r -F -d 'Write an HTML report'
+complete -c kss -l report-markdown -r -F -d 'Write a markdown report'
+complete -c kss -l theme -x -a 'default solarized dracula high-contrast colorblind ascii-only' -d 'Colour theme'
+complete -c kss -l timezone -x -a 'local utc' -d 'Timezone of timestamps'
+complete -c kss -l timeout -x -d 'Timeout of kubectl commands'
+complete -c kss -l no-pager -d 'Do not page the output'
+complete -c kss -l no-color -d 'Disable colours'
+complete -c kss -l force-color -d 'Keep colours when not on a tty'